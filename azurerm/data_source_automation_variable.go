@@ -0,0 +1,102 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+)
+
+func dataSourceArmAutomationVariable() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmAutomationVariableRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			"automation_account_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"encrypted": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"value": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"raw_value": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceArmAutomationVariableRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).automationVariableClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	accountName := d.Get("automation_account_name").(string)
+
+	resp, err := client.Get(ctx, resourceGroup, accountName, name)
+	if err != nil {
+		return fmt.Errorf("Error reading Automation Variable %q (Automation Account Name %q / Resource Group %q): %+v", name, accountName, resourceGroup, err)
+	}
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Automation Variable %q (Automation Account Name %q / Resource Group %q) ID", name, accountName, resourceGroup)
+	}
+	d.SetId(*resp.ID)
+
+	properties := resp.VariableProperties
+	if properties == nil {
+		return fmt.Errorf("Error reading Automation Variable %q (Automation Account Name %q / Resource Group %q): `properties` was nil", name, accountName, resourceGroup)
+	}
+
+	d.Set("description", properties.Description)
+	d.Set("encrypted", properties.IsEncrypted)
+
+	if properties.Value == nil {
+		return nil
+	}
+	d.Set("raw_value", *properties.Value)
+
+	isEncrypted := properties.IsEncrypted != nil && *properties.IsEncrypted
+	if isEncrypted {
+		// the decoded value can't be recovered once it's encrypted server-side - only `raw_value` is available.
+		return nil
+	}
+
+	varType, value, err := azure.DetectAndDecodeAutomationVariableValue(*properties.Value)
+	if err != nil {
+		return fmt.Errorf("Error decoding `value` for Automation Variable %q: %+v", name, err)
+	}
+	d.Set("type", string(varType))
+	d.Set("value", azure.FormatAutomationVariableValue(varType, value))
+
+	return nil
+}
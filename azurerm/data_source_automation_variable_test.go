@@ -0,0 +1,64 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccDataSourceAzureRMAutomationVariable_int(t *testing.T) {
+	dataSourceName := "data.azurerm_automation_variable.test"
+	ri := tf.AccRandTimeInt()
+	rs := testAccRandString(5)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMAutomationVariable_basic(ri, rs, location, "int", "1234"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "type", "int"),
+					resource.TestCheckResourceAttr(dataSourceName, "value", "1234"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAzureRMAutomationVariable_datetime(t *testing.T) {
+	dataSourceName := "data.azurerm_automation_variable.test"
+	ri := tf.AccRandTimeInt()
+	rs := testAccRandString(5)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMAutomationVariable_basic(ri, rs, location, "datetime", "2019-04-24T21:40:21Z"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "type", "datetime"),
+					resource.TestCheckResourceAttr(dataSourceName, "value", "2019-04-24T21:40:21Z"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMAutomationVariable_basic(rInt int, rString string, location string, varType string, value string) string {
+	template := testAccAzureRMAutomationVariable_basic(rInt, rString, location, varType, value)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_automation_variable" "test" {
+  name                    = "${azurerm_automation_variable.test.name}"
+  resource_group_name     = "${azurerm_resource_group.test.name}"
+  automation_account_name = "${azurerm_automation_account.test.name}"
+}
+`, template)
+}
@@ -0,0 +1,217 @@
+package azure
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// AutomationVariableType enumerates the value types an Automation Variable can hold.
+type AutomationVariableType string
+
+const (
+	AutomationVariableBool     AutomationVariableType = "bool"
+	AutomationVariableDateTime AutomationVariableType = "datetime"
+	AutomationVariableInt      AutomationVariableType = "int"
+	AutomationVariableString   AutomationVariableType = "string"
+)
+
+// the Automation service transports datetime values as a JSON string wrapping the
+// `/Date(<unix-ms>)/` token used by WCF/ASP.NET AJAX's date convention.
+const automationVariableDateTimePrefix = "\"\\/Date("
+const automationVariableDateTimeSuffix = ")\\/\""
+
+// EncodeAutomationVariableValue converts a native Go value for the given Automation Variable
+// type into the raw string the Automation API expects as the `Value` property.
+func EncodeAutomationVariableValue(varType AutomationVariableType, value interface{}) (string, error) {
+	switch varType {
+	case AutomationVariableBool:
+		v, ok := value.(bool)
+		if !ok {
+			return "", fmt.Errorf("Expected a bool value for an Automation Variable of type %q", varType)
+		}
+		return strconv.FormatBool(v), nil
+
+	case AutomationVariableInt:
+		v, ok := value.(int)
+		if !ok {
+			return "", fmt.Errorf("Expected an int value for an Automation Variable of type %q", varType)
+		}
+		return strconv.Itoa(v), nil
+
+	case AutomationVariableDateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return "", fmt.Errorf("Expected a time.Time value for an Automation Variable of type %q", varType)
+		}
+		millis := automationVariableUnixMillis(v)
+		return fmt.Sprintf("%s%d%s", automationVariableDateTimePrefix, millis, automationVariableDateTimeSuffix), nil
+
+	case AutomationVariableString:
+		v, ok := value.(string)
+		if !ok {
+			return "", fmt.Errorf("Expected a string value for an Automation Variable of type %q", varType)
+		}
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return "", fmt.Errorf("Error encoding Automation Variable string value: %+v", err)
+		}
+		return string(encoded), nil
+	}
+
+	return "", fmt.Errorf("Unsupported Automation Variable type %q", varType)
+}
+
+// DecodeAutomationVariableValue converts the raw string returned by the Automation API back
+// into a native Go value for the given Automation Variable type.
+func DecodeAutomationVariableValue(varType AutomationVariableType, raw string) (interface{}, error) {
+	switch varType {
+	case AutomationVariableBool:
+		return strconv.ParseBool(raw)
+
+	case AutomationVariableInt:
+		return strconv.Atoi(raw)
+
+	case AutomationVariableDateTime:
+		return decodeAutomationVariableDateTime(raw)
+
+	case AutomationVariableString:
+		var v string
+		if err := json.Unmarshal([]byte(raw), &v); err != nil {
+			return nil, fmt.Errorf("Error decoding Automation Variable string value %q: %+v", raw, err)
+		}
+		return v, nil
+	}
+
+	return nil, fmt.Errorf("Unsupported Automation Variable type %q", varType)
+}
+
+// ParseAutomationVariableValueFromConfig parses the string representation stored in a
+// Terraform configuration's `value` attribute into the native Go type for varType.
+func ParseAutomationVariableValueFromConfig(varType AutomationVariableType, raw string) (interface{}, error) {
+	switch varType {
+	case AutomationVariableBool:
+		v, err := strconv.ParseBool(raw)
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing %q as a bool: %+v", raw, err)
+		}
+		return v, nil
+
+	case AutomationVariableInt:
+		v, err := strconv.Atoi(raw)
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing %q as an int: %+v", raw, err)
+		}
+		return v, nil
+
+	case AutomationVariableDateTime:
+		v, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing %q as an RFC3339 datetime: %+v", raw, err)
+		}
+		return v, nil
+
+	case AutomationVariableString:
+		return raw, nil
+	}
+
+	return nil, fmt.Errorf("Unsupported Automation Variable type %q", varType)
+}
+
+// FormatAutomationVariableValue renders a native Go value back into the string representation
+// stored in a Terraform configuration's `value` attribute.
+func FormatAutomationVariableValue(varType AutomationVariableType, value interface{}) string {
+	switch varType {
+	case AutomationVariableBool:
+		return strconv.FormatBool(value.(bool))
+	case AutomationVariableInt:
+		return strconv.Itoa(value.(int))
+	case AutomationVariableDateTime:
+		return value.(time.Time).Format(time.RFC3339)
+	case AutomationVariableString:
+		return value.(string)
+	}
+	return ""
+}
+
+// DetectAndDecodeAutomationVariableValue infers the Automation Variable type of a raw value
+// returned by the API and decodes it, for callers (such as a data source) which don't know the
+// type ahead of time.
+func DetectAndDecodeAutomationVariableValue(raw string) (AutomationVariableType, interface{}, error) {
+	if strings.HasPrefix(raw, automationVariableDateTimePrefix) && strings.HasSuffix(raw, automationVariableDateTimeSuffix) {
+		v, err := decodeAutomationVariableDateTime(raw)
+		return AutomationVariableDateTime, v, err
+	}
+
+	// int must be checked before bool: strconv.ParseBool accepts "0"/"1" as valid booleans, which
+	// would otherwise misdetect an int variable whose value happens to be 0 or 1.
+	if v, err := strconv.Atoi(raw); err == nil {
+		return AutomationVariableInt, v, nil
+	}
+
+	if v, err := strconv.ParseBool(raw); err == nil {
+		return AutomationVariableBool, v, nil
+	}
+
+	v, err := DecodeAutomationVariableValue(AutomationVariableString, raw)
+	return AutomationVariableString, v, err
+}
+
+func decodeAutomationVariableDateTime(raw string) (time.Time, error) {
+	if !strings.HasPrefix(raw, automationVariableDateTimePrefix) || !strings.HasSuffix(raw, automationVariableDateTimeSuffix) {
+		return time.Time{}, fmt.Errorf("Automation Variable value %q is not in the expected `/Date(...)/ ` format", raw)
+	}
+
+	millisStr := strings.TrimSuffix(strings.TrimPrefix(raw, automationVariableDateTimePrefix), automationVariableDateTimeSuffix)
+	millis, err := strconv.ParseInt(millisStr, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("Error parsing Automation Variable datetime value %q: %+v", raw, err)
+	}
+
+	// building nanoseconds via `millis*int64(time.Millisecond)` overflows int64 for the same class
+	// of far-future/far-past timestamps `automationVariableUnixMillis` guards against on encode,
+	// so split into whole seconds plus a millisecond remainder instead.
+	return time.Unix(millis/1000, (millis%1000)*int64(time.Millisecond)).UTC(), nil
+}
+
+// automationVariableUnixMillis returns the number of milliseconds since the Unix epoch for v.
+// v.UnixNano() overflows int64 for times outside ~1678-2262, so the millisecond count is derived
+// from Unix() and Nanosecond() instead, which stays valid for any time within about 292 million
+// years of 1970 (the range of an int64 millisecond count).
+func automationVariableUnixMillis(v time.Time) int64 {
+	return v.Unix()*1000 + int64(v.Nanosecond())/int64(time.Millisecond)
+}
+
+// resolveAutomationVariableDateTimeLayout maps a `value_format` attribute to a Go time layout -
+// "rfc3339" and "rfc3339nano" are convenience aliases, anything else is treated as a literal Go
+// reference-time layout so operators can match whatever format their source system emits.
+func resolveAutomationVariableDateTimeLayout(format string) string {
+	switch format {
+	case "", "rfc3339":
+		return time.RFC3339
+	case "rfc3339nano":
+		return time.RFC3339Nano
+	default:
+		return format
+	}
+}
+
+// ParseAutomationVariableDateTimeValue parses a `value` string using the layout implied by
+// `value_format` (see resolveAutomationVariableDateTimeLayout).
+func ParseAutomationVariableDateTimeValue(raw, format string) (time.Time, error) {
+	layout := resolveAutomationVariableDateTimeLayout(format)
+	v, err := time.Parse(layout, raw)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("Error parsing %q as a datetime with `value_format` %q: %+v", raw, format, err)
+	}
+	return v, nil
+}
+
+// FormatAutomationVariableDateTimeValue renders a time.Time back using the layout implied by
+// `value_format`, so a Read matches input written in that layout. Decoded values are in UTC, so
+// input written with another offset reads back as the same instant in UTC.
+func FormatAutomationVariableDateTimeValue(v time.Time, format string) string {
+	return v.Format(resolveAutomationVariableDateTimeLayout(format))
+}
@@ -0,0 +1,148 @@
+package azure
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAutomationVariableDateTimeRoundTrip(t *testing.T) {
+	cases := []struct {
+		name  string
+		value string
+	}{
+		{
+			name:  "fractional seconds",
+			value: "2019-04-24T21:40:21.123Z",
+		},
+		{
+			name:  "pre-1970",
+			value: "1955-11-12T22:04:00Z",
+		},
+		{
+			name:  "non-UTC offset",
+			value: "2019-04-24T21:40:21-07:00",
+		},
+		{
+			// UnixNano() overflows int64 for this date - the encode/decode path must not rely on it.
+			name:  "far future beyond int64 nanosecond range",
+			value: "3000-01-01T00:00:00Z",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			parsed, err := time.Parse(time.RFC3339Nano, tc.value)
+			if err != nil {
+				t.Fatalf("failed to parse test fixture %q: %+v", tc.value, err)
+			}
+
+			encoded, err := EncodeAutomationVariableValue(AutomationVariableDateTime, parsed)
+			if err != nil {
+				t.Fatalf("EncodeAutomationVariableValue: %+v", err)
+			}
+
+			decoded, err := DecodeAutomationVariableValue(AutomationVariableDateTime, encoded)
+			if err != nil {
+				t.Fatalf("DecodeAutomationVariableValue: %+v", err)
+			}
+
+			decodedTime := decoded.(time.Time)
+			if !decodedTime.Equal(parsed) {
+				t.Fatalf("round-trip mismatch: got %s, want %s", decodedTime, parsed)
+			}
+		})
+	}
+}
+
+func TestAutomationVariableUnixMillisDoesNotOverflow(t *testing.T) {
+	// year 3000 overflows int64 nanoseconds (time.Time.UnixNano() is only valid ~1678-2262) but
+	// must still round-trip through the production encode/decode functions.
+	farFuture := time.Date(3000, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	encoded, err := EncodeAutomationVariableValue(AutomationVariableDateTime, farFuture)
+	if err != nil {
+		t.Fatalf("EncodeAutomationVariableValue: %+v", err)
+	}
+
+	decoded, err := decodeAutomationVariableDateTime(encoded)
+	if err != nil {
+		t.Fatalf("decodeAutomationVariableDateTime: %+v", err)
+	}
+
+	if !decoded.Equal(farFuture) {
+		t.Fatalf("round-trip mismatch: got %s, want %s", decoded, farFuture)
+	}
+}
+
+func TestDetectAndDecodeAutomationVariableValue(t *testing.T) {
+	cases := []struct {
+		name          string
+		raw           string
+		expectedType  AutomationVariableType
+		expectedValue interface{}
+	}{
+		{
+			name:          "int value of 0 is not misdetected as bool",
+			raw:           "0",
+			expectedType:  AutomationVariableInt,
+			expectedValue: 0,
+		},
+		{
+			name:          "int value of 1 is not misdetected as bool",
+			raw:           "1",
+			expectedType:  AutomationVariableInt,
+			expectedValue: 1,
+		},
+		{
+			name:          "bool true is still detected as bool",
+			raw:           "true",
+			expectedType:  AutomationVariableBool,
+			expectedValue: true,
+		},
+		{
+			name:          "bool false is still detected as bool",
+			raw:           "false",
+			expectedType:  AutomationVariableBool,
+			expectedValue: false,
+		},
+		{
+			name:          "other ints are still detected as int",
+			raw:           "1234",
+			expectedType:  AutomationVariableInt,
+			expectedValue: 1234,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			varType, value, err := DetectAndDecodeAutomationVariableValue(tc.raw)
+			if err != nil {
+				t.Fatalf("DetectAndDecodeAutomationVariableValue: %+v", err)
+			}
+			if varType != tc.expectedType {
+				t.Fatalf("type mismatch: got %q, want %q", varType, tc.expectedType)
+			}
+			if value != tc.expectedValue {
+				t.Fatalf("value mismatch: got %#v, want %#v", value, tc.expectedValue)
+			}
+		})
+	}
+}
+
+func TestResolveAutomationVariableDateTimeLayout(t *testing.T) {
+	cases := []struct {
+		format   string
+		expected string
+	}{
+		{format: "", expected: time.RFC3339},
+		{format: "rfc3339", expected: time.RFC3339},
+		{format: "rfc3339nano", expected: time.RFC3339Nano},
+		{format: "2006-01-02", expected: "2006-01-02"},
+	}
+
+	for _, tc := range cases {
+		if got := resolveAutomationVariableDateTimeLayout(tc.format); got != tc.expected {
+			t.Errorf("resolveAutomationVariableDateTimeLayout(%q) = %q, want %q", tc.format, got, tc.expected)
+		}
+	}
+}
@@ -0,0 +1,405 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/automation/mgmt/2015-10-31/automation"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmAutomationAccount() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmAutomationAccountCreateUpdate,
+		Read:   resourceArmAutomationAccountRead,
+		Update: resourceArmAutomationAccountCreateUpdate,
+		Delete: resourceArmAutomationAccountDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"sku_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			// encryption's `user_assigned_identity_id` (and a System Assigned identity's service
+			// principal) must be granted access to the Key Vault out-of-band - the `identity`
+			// block below is what actually gives the Automation service something to grant.
+			"identity": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(automation.ResourceIdentityTypeSystemAssigned),
+								string(automation.ResourceIdentityTypeUserAssigned),
+								string(automation.ResourceIdentityTypeSystemAssignedUserAssigned),
+							}, false),
+						},
+
+						"identity_ids": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validate.NoEmptyStrings,
+							},
+						},
+
+						"principal_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"tenant_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			// encryption is account-scoped: once a customer-managed key is configured here every
+			// encrypted Automation Variable underneath this account is protected by it.
+			"encryption": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key_vault_key_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"user_assigned_identity_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+					},
+				},
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmAutomationAccountCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).automationAccountClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if requireResourcesToBeImported {
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Error checking for present of existing Automation Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+		if !utils.ResponseWasNotFound(resp.Response) {
+			return tf.ImportAsExistsError("azurerm_automation_account", *resp.ID)
+		}
+	}
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	encryption, err := expandArmAutomationAccountEncryption(d.Get("encryption").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	parameters := automation.AccountCreateOrUpdateParameters{
+		Name:     utils.String(name),
+		Location: utils.String(location),
+		Identity: expandArmAutomationAccountIdentity(d.Get("identity").([]interface{})),
+		AccountCreateOrUpdateProperties: &automation.AccountCreateOrUpdateProperties{
+			Sku: &automation.Sku{
+				Name: automation.SkuNameEnum(d.Get("sku_name").(string)),
+			},
+			Encryption: encryption,
+		},
+		Tags: expandTags(tags),
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Automation Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Automation Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Automation Account %q (Resource Group %q) ID", name, resourceGroup)
+	}
+	d.SetId(*resp.ID)
+
+	return resourceArmAutomationAccountRead(d, meta)
+}
+
+func resourceArmAutomationAccountRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).automationAccountClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["automationAccounts"]
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] Automation Account %q does not exist - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Automation Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+	if err := d.Set("identity", flattenArmAutomationAccountIdentity(resp.Identity)); err != nil {
+		return fmt.Errorf("Error setting `identity`: %+v", err)
+	}
+
+	if properties := resp.AccountProperties; properties != nil {
+		if sku := properties.Sku; sku != nil {
+			d.Set("sku_name", string(sku.Name))
+		}
+		if err := d.Set("encryption", flattenArmAutomationAccountEncryption(properties.Encryption)); err != nil {
+			return fmt.Errorf("Error setting `encryption`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmAutomationAccountDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).automationAccountClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["automationAccounts"]
+
+	if _, err := client.Delete(ctx, resourceGroup, name); err != nil {
+		return fmt.Errorf("Error deleting Automation Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+// automationAccountHasCustomerManagedKey returns whether the given Automation Account has a
+// customer-managed (Key Vault-backed) encryption key configured, so that encrypted variables
+// underneath it can be validated when they're created or updated.
+func automationAccountHasCustomerManagedKey(ctx context.Context, client automation.AccountClient, resourceGroup, accountName string) (bool, error) {
+	resp, err := client.Get(ctx, resourceGroup, accountName)
+	if err != nil {
+		return false, fmt.Errorf("Error retrieving Automation Account %q (Resource Group %q): %+v", accountName, resourceGroup, err)
+	}
+
+	if properties := resp.AccountProperties; properties != nil {
+		if encryption := properties.Encryption; encryption != nil {
+			if keyVault := encryption.KeyVaultProperties; keyVault != nil {
+				return keyVault.KeyName != nil && *keyVault.KeyName != "", nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+func expandArmAutomationAccountEncryption(input []interface{}) (*automation.EncryptionProperties, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	vaultBaseURL, keyName, keyVersion, err := parseKeyVaultKeyID(v["key_vault_key_id"].(string))
+	if err != nil {
+		return nil, err
+	}
+
+	encryption := automation.EncryptionProperties{
+		KeySource: automation.MicrosoftKeyvault,
+		KeyVaultProperties: &automation.KeyVaultProperties{
+			KeyvaultUri: utils.String(vaultBaseURL),
+			KeyName:     utils.String(keyName),
+			KeyVersion:  utils.String(keyVersion),
+		},
+	}
+
+	if identityID, ok := v["user_assigned_identity_id"].(string); ok && identityID != "" {
+		encryption.Identity = &automation.EncryptionPropertiesIdentity{
+			UserAssignedIdentity: utils.String(identityID),
+		}
+	}
+
+	return &encryption, nil
+}
+
+// parseKeyVaultKeyID splits a Key Vault Key ID (e.g.
+// `https://my-keyvault.vault.azure.net/keys/my-key/abcdef0123456789`) into the vault's base URL,
+// the key name and the key version - the three discrete fields the Automation service's
+// `KeyVaultProperties` actually stores.
+func parseKeyVaultKeyID(raw string) (vaultBaseURL string, keyName string, keyVersion string, err error) {
+	idURL, err := url.Parse(raw)
+	if err != nil {
+		return "", "", "", fmt.Errorf("Error parsing Key Vault Key ID %q: %+v", raw, err)
+	}
+
+	components := strings.Split(strings.Trim(idURL.Path, "/"), "/")
+	if len(components) < 2 || components[0] != "keys" || components[1] == "" {
+		return "", "", "", fmt.Errorf("Error parsing Key Vault Key ID %q: expected a path of the form `/keys/{name}` or `/keys/{name}/{version}`", raw)
+	}
+
+	vaultBaseURL = fmt.Sprintf("%s://%s/", idURL.Scheme, idURL.Host)
+	keyName = components[1]
+	if len(components) > 2 {
+		keyVersion = components[2]
+	}
+
+	return vaultBaseURL, keyName, keyVersion, nil
+}
+
+// validateAutomationAccountSupportsEncryptedVariable returns an error unless the given
+// Automation Account has a customer-managed key configured, since the service otherwise has
+// nowhere to store an `encrypted = true` variable's key material.
+func validateAutomationAccountSupportsEncryptedVariable(meta interface{}, resourceGroup, accountName string) error {
+	client := meta.(*ArmClient).automationAccountClient
+	ctx := meta.(*ArmClient).StopContext
+
+	hasKey, err := automationAccountHasCustomerManagedKey(ctx, client, resourceGroup, accountName)
+	if err != nil {
+		return err
+	}
+	if !hasKey {
+		return fmt.Errorf("`encrypted` can only be set to `true` when Automation Account %q (Resource Group %q) has a customer-managed key configured via its `encryption` block", accountName, resourceGroup)
+	}
+
+	return nil
+}
+
+func expandArmAutomationAccountIdentity(input []interface{}) *automation.Identity {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	identity := automation.Identity{
+		Type: automation.ResourceIdentityType(v["type"].(string)),
+	}
+
+	identityIdsRaw := v["identity_ids"].([]interface{})
+	if len(identityIdsRaw) > 0 {
+		identityIds := make(map[string]*automation.IdentityUserAssignedIdentitiesValue)
+		for _, raw := range identityIdsRaw {
+			identityIds[raw.(string)] = &automation.IdentityUserAssignedIdentitiesValue{}
+		}
+		identity.IdentityIds = identityIds
+	}
+
+	return &identity
+}
+
+func flattenArmAutomationAccountIdentity(input *automation.Identity) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	identityIds := make([]interface{}, 0)
+	for id := range input.IdentityIds {
+		identityIds = append(identityIds, id)
+	}
+
+	principalID := ""
+	if input.PrincipalID != nil {
+		principalID = *input.PrincipalID
+	}
+
+	tenantID := ""
+	if input.TenantID != nil {
+		tenantID = *input.TenantID
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"type":         string(input.Type),
+			"identity_ids": identityIds,
+			"principal_id": principalID,
+			"tenant_id":    tenantID,
+		},
+	}
+}
+
+func flattenArmAutomationAccountEncryption(input *automation.EncryptionProperties) []interface{} {
+	if input == nil || input.KeyVaultProperties == nil {
+		return []interface{}{}
+	}
+
+	keyVault := input.KeyVaultProperties
+	keyVaultKeyID := ""
+	if keyVault.KeyvaultUri != nil && keyVault.KeyName != nil {
+		version := ""
+		if keyVault.KeyVersion != nil {
+			version = *keyVault.KeyVersion
+		}
+		keyVaultKeyID = fmt.Sprintf("%skeys/%s/%s", *keyVault.KeyvaultUri, *keyVault.KeyName, version)
+	}
+
+	userAssignedIdentityID := ""
+	if input.Identity != nil && input.Identity.UserAssignedIdentity != nil {
+		userAssignedIdentityID = *input.Identity.UserAssignedIdentity
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"key_vault_key_id":          keyVaultKeyID,
+			"user_assigned_identity_id": userAssignedIdentityID,
+		},
+	}
+}
@@ -0,0 +1,76 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// testAccRandString returns a random lower-case alphanumeric string of length n, used to keep
+// acceptance test resource names unique across parallel runs against the same subscription -
+// unlike tf.AccRandTimeInt(), two tests started in the same second can't collide on it.
+func testAccRandString(n int) string {
+	return strings.ToLower(acctest.RandStringFromCharSet(n, acctest.CharSetAlphaNum))
+}
+
+func init() {
+	resource.AddTestSweepers("azurerm_automation_account", &resource.Sweeper{
+		Name: "azurerm_automation_account",
+		F:    testSweepAutomationAccounts,
+	})
+}
+
+// testSweepAutomationAccounts deletes Automation Accounts left behind by acceptance tests that
+// were interrupted before their CheckDestroy ran - the accounts themselves are cheap, but each
+// leaked one keeps its Automation Variables (and any CMK Key Vault role assignments) around too.
+func testSweepAutomationAccounts(region string) error {
+	armClient, err := buildConfigForSweepers()
+	if err != nil {
+		return err
+	}
+	client := armClient.automationAccountClient
+	ctx := armClient.StopContext
+
+	cutoff := time.Now().Add(-6 * time.Hour)
+
+	results, err := client.ListBySubscription(ctx)
+	if err != nil {
+		return fmt.Errorf("Error listing Automation Accounts: %+v", err)
+	}
+
+	for ; results.NotDone(); err = results.NextWithContext(ctx) {
+		if err != nil {
+			return fmt.Errorf("Error listing Automation Accounts: %+v", err)
+		}
+
+		for _, account := range results.Values() {
+			if account.Name == nil || !strings.HasPrefix(*account.Name, "acctest") {
+				continue
+			}
+
+			if account.AccountProperties != nil && account.AccountProperties.LastModifiedTime != nil {
+				lastModified := time.Time(*account.AccountProperties.LastModifiedTime)
+				if lastModified.After(cutoff) {
+					continue
+				}
+			}
+
+			id, err := parseAzureResourceID(*account.ID)
+			if err != nil {
+				log.Printf("[WARN] Unable to parse Automation Account ID %q: %+v", *account.ID, err)
+				continue
+			}
+
+			log.Printf("[DEBUG] Deleting leaked Automation Account %q (Resource Group %q)", *account.Name, id.ResourceGroup)
+			if _, err := client.Delete(ctx, id.ResourceGroup, *account.Name); err != nil {
+				log.Printf("[WARN] Error deleting Automation Account %q (Resource Group %q): %+v", *account.Name, id.ResourceGroup, err)
+			}
+		}
+	}
+
+	return nil
+}
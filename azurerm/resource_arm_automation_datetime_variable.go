@@ -56,6 +56,17 @@ func resourceArmAutomationDatetimeVariable() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+
+			// the Automation service always stores `value` as a millisecond Unix timestamp, so
+			// `value_format` only controls how Terraform parses and renders it - letting it
+			// default to "rfc3339" keeps existing whole-second configurations working unchanged,
+			// while any fractional part is dropped on read unless "rfc3339nano" is used.
+			"value_format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "rfc3339",
+				ValidateFunc: validate.NoEmptyStrings,
+			},
 		},
 	}
 }
@@ -82,11 +93,22 @@ func resourceArmAutomationDatetimeVariableCreateUpdate(d *schema.ResourceData, m
 
 	description := d.Get("description").(string)
 	encrypted := d.Get("encrypted").(bool)
-	vTime, parseErr := time.Parse(time.RFC3339, d.Get("value").(string))
+
+	if encrypted {
+		if err := validateAutomationAccountSupportsEncryptedVariable(meta, resourceGroup, accountName); err != nil {
+			return err
+		}
+	}
+
+	valueFormat := d.Get("value_format").(string)
+	vTime, parseErr := azure.ParseAutomationVariableDateTimeValue(d.Get("value").(string), valueFormat)
 	if parseErr != nil {
 		return fmt.Errorf("Error invalid time format: %+v", parseErr)
 	}
-	value := fmt.Sprintf("\"\\/Date(%d)\\/\"", vTime.UnixNano()/1000000)
+	value, err := azure.EncodeAutomationVariableValue(azure.AutomationVariableDateTime, vTime)
+	if err != nil {
+		return fmt.Errorf("Error encoding `value`: %+v", err)
+	}
 
 	parameters := automation.VariableCreateOrUpdateParameters{
 		Name: utils.String(name),
@@ -141,12 +163,12 @@ func resourceArmAutomationDatetimeVariableRead(d *schema.ResourceData, meta inte
 	if properties := resp.VariableProperties; properties != nil {
 		d.Set("description", properties.Description)
 		d.Set("encrypted", properties.IsEncrypted)
-		if !d.Get("encrypted").(bool) {
-			value, err := azure.ParseAzureRmAutomationVariableValue("azurerm_automation_datetime_variable", properties.Value)
+		if !d.Get("encrypted").(bool) && properties.Value != nil {
+			value, err := azure.DecodeAutomationVariableValue(azure.AutomationVariableDateTime, *properties.Value)
 			if err != nil {
 				return err
 			}
-			d.Set("value", value.(time.Time).Format("2006-01-02T15:04:05.999Z"))
+			d.Set("value", azure.FormatAutomationVariableDateTimeValue(value.(time.Time), d.Get("value_format").(string)))
 		}
 	}
 
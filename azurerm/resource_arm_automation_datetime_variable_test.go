@@ -0,0 +1,176 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMAutomationDatetimeVariable_encrypted(t *testing.T) {
+	resourceName := "azurerm_automation_datetime_variable.test"
+	ri := tf.AccRandTimeInt()
+	rs := testAccRandString(5)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAutomationDatetimeVariableDestroy,
+		Steps: []resource.TestStep{
+			{
+				// the Automation Account's identity must exist (and be granted Key Vault access)
+				// before the customer-managed key can be configured on it, so the encryption block
+				// and the encrypted variable are only added in the second step.
+				Config: testAccAzureRMAutomationDatetimeVariable_encryptedSetup(ri, rs, location),
+			},
+			{
+				Config: testAccAzureRMAutomationDatetimeVariable_encrypted(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAutomationDatetimeVariableExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "encrypted", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMAutomationDatetimeVariableExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Automation Datetime Variable not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		accountName := rs.Primary.Attributes["automation_account_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).automationVariableClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		if resp, err := client.Get(ctx, resourceGroup, accountName, name); err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Automation Datetime Variable %q (Automation Account Name %q / Resource Group %q) does not exist", name, accountName, resourceGroup)
+			}
+			return fmt.Errorf("Bad: Get on automationVariableClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMAutomationDatetimeVariableDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).automationVariableClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_automation_datetime_variable" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		accountName := rs.Primary.Attributes["automation_account_name"]
+
+		if resp, err := client.Get(ctx, resourceGroup, accountName, name); err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Get on automationVariableClient: %+v", err)
+			}
+		}
+
+		return nil
+	}
+
+	return nil
+}
+
+// testAccAzureRMAutomationDatetimeVariable_encryptedTemplate renders the Resource Group, Automation
+// Account (with a System Assigned identity), Key Vault and Key common to both steps of
+// TestAccAzureRMAutomationDatetimeVariable_encrypted. `encryptionBlock` is empty in the first step
+// (the identity must exist, and be granted Key Vault access, before it can be told to use the key)
+// and is the account's `encryption { ... }` block in the second.
+func testAccAzureRMAutomationDatetimeVariable_encryptedTemplate(rInt int, rString string, location string, encryptionBlock string) string {
+	return fmt.Sprintf(`
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d-%s"
+  location = "%s"
+}
+
+resource "azurerm_automation_account" "test" {
+  name                = "acctestAutoAcct-%d-%s"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku_name            = "Basic"
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  %s
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctestkv-%d-%s"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  tenant_id           = "${data.azurerm_client_config.current.tenant_id}"
+  sku_name            = "standard"
+
+  access_policy {
+    tenant_id = "${data.azurerm_client_config.current.tenant_id}"
+    object_id = "${azurerm_automation_account.test.identity.0.principal_id}"
+
+    key_permissions = [
+      "create",
+      "get",
+      "list",
+      "wrapKey",
+      "unwrapKey",
+    ]
+  }
+}
+
+resource "azurerm_key_vault_key" "test" {
+  name         = "acctestkvkey-%d-%s"
+  key_vault_id = "${azurerm_key_vault.test.id}"
+  key_type     = "RSA"
+  key_size     = 2048
+
+  key_opts = [
+    "decrypt",
+    "encrypt",
+    "unwrapKey",
+    "wrapKey",
+  ]
+}
+`, rInt, rString, location, rInt, rString, encryptionBlock, rInt, rString, rInt, rString)
+}
+
+func testAccAzureRMAutomationDatetimeVariable_encryptedSetup(rInt int, rString string, location string) string {
+	return testAccAzureRMAutomationDatetimeVariable_encryptedTemplate(rInt, rString, location, "")
+}
+
+func testAccAzureRMAutomationDatetimeVariable_encrypted(rInt int, rString string, location string) string {
+	encryptionBlock := `
+  encryption {
+    key_vault_key_id = "${azurerm_key_vault_key.test.id}"
+  }`
+
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_automation_datetime_variable" "test" {
+  name                    = "acctestAutoVar-%d-%s"
+  resource_group_name     = "${azurerm_resource_group.test.name}"
+  automation_account_name = "${azurerm_automation_account.test.name}"
+  encrypted               = true
+  value                   = "2019-04-24T21:40:21.0000000Z"
+}
+`, testAccAzureRMAutomationDatetimeVariable_encryptedTemplate(rInt, rString, location, encryptionBlock), rInt, rString)
+}
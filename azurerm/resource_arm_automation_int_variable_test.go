@@ -13,6 +13,7 @@ import (
 func TestAccAzureRMAutomationIntVariable_basic(t *testing.T) {
 	resourceName := "azurerm_automation_int_variable.test"
 	ri := tf.AccRandTimeInt()
+	rs := testAccRandString(5)
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -21,7 +22,7 @@ func TestAccAzureRMAutomationIntVariable_basic(t *testing.T) {
 		CheckDestroy: testCheckAzureRMAutomationIntVariableDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAzureRMAutomationIntVariable_basic(ri, location),
+				Config: testAccAzureRMAutomationIntVariable_basic(ri, rs, location),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMAutomationIntVariableExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "value", "1234"),
@@ -39,6 +40,7 @@ func TestAccAzureRMAutomationIntVariable_basic(t *testing.T) {
 func TestAccAzureRMAutomationIntVariable_complete(t *testing.T) {
 	resourceName := "azurerm_automation_int_variable.test"
 	ri := tf.AccRandTimeInt()
+	rs := testAccRandString(5)
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -47,7 +49,7 @@ func TestAccAzureRMAutomationIntVariable_complete(t *testing.T) {
 		CheckDestroy: testCheckAzureRMAutomationIntVariableDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAzureRMAutomationIntVariable_complete(ri, location),
+				Config: testAccAzureRMAutomationIntVariable_complete(ri, rs, location),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMAutomationIntVariableExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "description", "This variable is created by Terraform acceptance test."),
@@ -66,6 +68,7 @@ func TestAccAzureRMAutomationIntVariable_complete(t *testing.T) {
 func TestAccAzureRMAutomationIntVariable_basicCompleteUpdate(t *testing.T) {
 	resourceName := "azurerm_automation_int_variable.test"
 	ri := tf.AccRandTimeInt()
+	rs := testAccRandString(5)
 	location := testLocation()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -74,14 +77,14 @@ func TestAccAzureRMAutomationIntVariable_basicCompleteUpdate(t *testing.T) {
 		CheckDestroy: testCheckAzureRMAutomationIntVariableDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAzureRMAutomationIntVariable_basic(ri, location),
+				Config: testAccAzureRMAutomationIntVariable_basic(ri, rs, location),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMAutomationIntVariableExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "value", "1234"),
 				),
 			},
 			{
-				Config: testAccAzureRMAutomationIntVariable_complete(ri, location),
+				Config: testAccAzureRMAutomationIntVariable_complete(ri, rs, location),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMAutomationIntVariableExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "description", "This variable is created by Terraform acceptance test."),
@@ -89,7 +92,7 @@ func TestAccAzureRMAutomationIntVariable_basicCompleteUpdate(t *testing.T) {
 				),
 			},
 			{
-				Config: testAccAzureRMAutomationIntVariable_basic(ri, location),
+				Config: testAccAzureRMAutomationIntVariable_basic(ri, rs, location),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMAutomationIntVariableExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "value", "1234"),
@@ -149,15 +152,15 @@ func testCheckAzureRMAutomationIntVariableDestroy(s *terraform.State) error {
 	return nil
 }
 
-func testAccAzureRMAutomationIntVariable_basic(rInt int, location string) string {
+func testAccAzureRMAutomationIntVariable_basic(rInt int, rString string, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
-  name     = "acctestRG-%d"
+  name     = "acctestRG-%d-%s"
   location = "%s"
 }
 
 resource "azurerm_automation_account" "test" {
-  name                = "acctestAutoAcct-%d"
+  name                = "acctestAutoAcct-%d-%s"
   location            = "${azurerm_resource_group.test.location}"
   resource_group_name = "${azurerm_resource_group.test.name}"
 
@@ -167,23 +170,23 @@ resource "azurerm_automation_account" "test" {
 }
 
 resource "azurerm_automation_int_variable" "test" {
-  name                    = "acctestAutoVar-%d"
+  name                    = "acctestAutoVar-%d-%s"
   resource_group_name     = "${azurerm_resource_group.test.name}"
   automation_account_name = "${azurerm_automation_account.test.name}"
   value                   = 1234
 }
-`, rInt, location, rInt, rInt)
+`, rInt, rString, location, rInt, rString, rInt, rString)
 }
 
-func testAccAzureRMAutomationIntVariable_complete(rInt int, location string) string {
+func testAccAzureRMAutomationIntVariable_complete(rInt int, rString string, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
-  name     = "acctestRG-%d"
+  name     = "acctestRG-%d-%s"
   location = "%s"
 }
 
 resource "azurerm_automation_account" "test" {
-  name                = "acctestAutoAcct-%d"
+  name                = "acctestAutoAcct-%d-%s"
   location            = "${azurerm_resource_group.test.location}"
   resource_group_name = "${azurerm_resource_group.test.name}"
 
@@ -193,11 +196,11 @@ resource "azurerm_automation_account" "test" {
 }
 
 resource "azurerm_automation_int_variable" "test" {
-  name                    = "acctestAutoVar-%d"
+  name                    = "acctestAutoVar-%d-%s"
   resource_group_name     = "${azurerm_resource_group.test.name}"
   automation_account_name = "${azurerm_automation_account.test.name}"
   description             = "This variable is created by Terraform acceptance test."
   value                   = 12345
 }
-`, rInt, location, rInt, rInt)
+`, rInt, rString, location, rInt, rString, rInt, rString)
 }
\ No newline at end of file
@@ -0,0 +1,241 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/automation/mgmt/2015-10-31/automation"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmAutomationVariable() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmAutomationVariableCreateUpdate,
+		Read:   resourceArmAutomationVariableRead,
+		Update: resourceArmAutomationVariableCreateUpdate,
+		Delete: resourceArmAutomationVariableDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"automation_account_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(azure.AutomationVariableBool),
+					string(azure.AutomationVariableDateTime),
+					string(azure.AutomationVariableInt),
+					string(azure.AutomationVariableString),
+				}, false),
+			},
+
+			"value": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// only meaningful when `type = "datetime"` - the Automation service always stores
+			// `value` as a millisecond Unix timestamp, so this only controls how Terraform parses
+			// and renders it. "rfc3339" (the default) renders whole seconds and drops any
+			// fractional part on read; "rfc3339nano" keeps it.
+			"value_format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "rfc3339",
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"encrypted": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceArmAutomationVariableCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).automationVariableClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	accountName := d.Get("automation_account_name").(string)
+
+	if requireResourcesToBeImported {
+		resp, err := client.Get(ctx, resourceGroup, accountName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Error checking for present of existing Automation Variable %q (Automation Account Name %q / Resource Group %q): %+v", name, accountName, resourceGroup, err)
+			}
+		}
+		if !utils.ResponseWasNotFound(resp.Response) {
+			return tf.ImportAsExistsError("azurerm_automation_variable", *resp.ID)
+		}
+	}
+
+	varType := azure.AutomationVariableType(d.Get("type").(string))
+	description := d.Get("description").(string)
+	encrypted := d.Get("encrypted").(bool)
+
+	if encrypted {
+		if err := validateAutomationAccountSupportsEncryptedVariable(meta, resourceGroup, accountName); err != nil {
+			return err
+		}
+	}
+
+	value := ""
+	if raw := d.Get("value").(string); raw != "" {
+		var nativeValue interface{}
+		if varType == azure.AutomationVariableDateTime {
+			v, err := azure.ParseAutomationVariableDateTimeValue(raw, d.Get("value_format").(string))
+			if err != nil {
+				return fmt.Errorf("Error parsing `value` for Automation Variable %q: %+v", name, err)
+			}
+			nativeValue = v
+		} else {
+			v, err := azure.ParseAutomationVariableValueFromConfig(varType, raw)
+			if err != nil {
+				return fmt.Errorf("Error parsing `value` for Automation Variable %q: %+v", name, err)
+			}
+			nativeValue = v
+		}
+
+		encoded, err := azure.EncodeAutomationVariableValue(varType, nativeValue)
+		if err != nil {
+			return fmt.Errorf("Error encoding `value` for Automation Variable %q: %+v", name, err)
+		}
+		value = encoded
+	}
+
+	parameters := automation.VariableCreateOrUpdateParameters{
+		Name: utils.String(name),
+		VariableCreateOrUpdateProperties: &automation.VariableCreateOrUpdateProperties{
+			Description: utils.String(description),
+			IsEncrypted: utils.Bool(encrypted),
+			Value:       utils.String(value),
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, accountName, name, parameters); err != nil {
+		return fmt.Errorf("Error creating Automation Variable %q (Automation Account Name %q / Resource Group %q): %+v", name, accountName, resourceGroup, err)
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, accountName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Automation Variable %q (Automation Account Name %q / Resource Group %q): %+v", name, accountName, resourceGroup, err)
+	}
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Automation Variable %q (Automation Account Name %q / Resource Group %q) ID", name, accountName, resourceGroup)
+	}
+	d.SetId(*resp.ID)
+
+	return resourceArmAutomationVariableRead(d, meta)
+}
+
+func resourceArmAutomationVariableRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).automationVariableClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	accountName := id.Path["automationAccounts"]
+	name := id.Path["variables"]
+
+	resp, err := client.Get(ctx, resourceGroup, accountName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] Automation Variable %q does not exist - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Automation Variable %q (Automation Account Name %q / Resource Group %q): %+v", name, accountName, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("automation_account_name", accountName)
+
+	if properties := resp.VariableProperties; properties != nil {
+		d.Set("description", properties.Description)
+		d.Set("encrypted", properties.IsEncrypted)
+
+		isEncrypted := properties.IsEncrypted != nil && *properties.IsEncrypted
+		if !isEncrypted && properties.Value != nil {
+			varType := azure.AutomationVariableType(d.Get("type").(string))
+			var value interface{}
+			if varType == "" {
+				// `type` and `value_format` aren't in state yet when importing, so the type is
+				// inferred from the raw value and `value_format` takes its default.
+				varType, value, err = azure.DetectAndDecodeAutomationVariableValue(*properties.Value)
+				d.Set("type", string(varType))
+				d.Set("value_format", "rfc3339")
+			} else {
+				value, err = azure.DecodeAutomationVariableValue(varType, *properties.Value)
+			}
+			if err != nil {
+				return fmt.Errorf("Error decoding `value` for Automation Variable %q: %+v", name, err)
+			}
+
+			if varType == azure.AutomationVariableDateTime {
+				d.Set("value", azure.FormatAutomationVariableDateTimeValue(value.(time.Time), d.Get("value_format").(string)))
+			} else {
+				d.Set("value", azure.FormatAutomationVariableValue(varType, value))
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceArmAutomationVariableDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).automationVariableClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	accountName := id.Path["automationAccounts"]
+	name := id.Path["variables"]
+
+	if _, err := client.Delete(ctx, resourceGroup, accountName, name); err != nil {
+		return fmt.Errorf("Error deleting Automation Variable %q (Automation Account Name %q / Resource Group %q): %+v", name, accountName, resourceGroup, err)
+	}
+
+	return nil
+}